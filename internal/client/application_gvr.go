@@ -0,0 +1,7 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+// ApplicationGVR represents an Argo CD Application CR.
+var ApplicationGVR = NewGVR("argoproj.io/v1alpha1/applications")
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// appInstanceLabel is the tracking label Argo CD stamps on every resource it
+// manages so live cluster objects can be associated back to the Application
+// that owns them.
+const appInstanceLabel = "app.kubernetes.io/instance"
+
+var _ Accessor = (*Application)(nil)
+
+// Application represents an Argo CD Application CR accessor.
+type Application struct {
+	Generic
+}
+
+// ManagedResources returns the GVR+namespace+name tuples this Application
+// reports under status.resources[], used to drill down from an Application
+// to the live objects it manages.
+func (a *Application) ManagedResources(ctx context.Context, path string) ([]ManagedResource, error) {
+	o, err := a.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expected *unstructured.Unstructured for application %q but got %T", path, o)
+	}
+
+	raw, found, err := unstructured.NestedSlice(u.Object, "status", "resources")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	rr := make([]ManagedResource, 0, len(raw))
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(m, "group")
+		kind, _, _ := unstructured.NestedString(m, "kind")
+		version, _, _ := unstructured.NestedString(m, "version")
+		ns, _, _ := unstructured.NestedString(m, "namespace")
+		name, _, _ := unstructured.NestedString(m, "name")
+
+		resource, err := a.resourceNameFor(group, version, kind)
+		if err != nil {
+			slog.Warn("Skipping managed resource with no known plural resource name",
+				"group", group, "version", version, "kind", kind, slogs.Error, err)
+			continue
+		}
+		rr = append(rr, ManagedResource{
+			GVR:       client.NewGVR(group + "/" + version + "/" + resource),
+			Kind:      kind,
+			Namespace: ns,
+			Name:      name,
+		})
+	}
+
+	return rr, nil
+}
+
+// resourceNameFor resolves a CR's kind (eg. "Deployment") to the plural
+// resource name (eg. "deployments") client.GVR expects, via the cluster's
+// RESTMapper -- kind and resource aren't always a trivial pluralization
+// (eg. Ingress -> ingresses, Endpoints has no singular), so this can't be
+// string-mangled the way the managed-resource kinds initially were.
+func (a *Application) resourceNameFor(group, version, kind string) (string, error) {
+	mapper, err := a.Client().RESTMapper()
+	if err != nil {
+		return "", err
+	}
+
+	m, err := mapper.RESTMapping(schema.GroupKind{Group: group, Kind: kind}, version)
+	if err != nil {
+		return "", err
+	}
+
+	return m.Resource.Resource, nil
+}
+
+// ManagedResource identifies a live cluster object reported by an
+// Application's status.resources[]. Kind is kept alongside the resolved
+// GVR so owner-reference matching can be scoped to kind+name instead of a
+// flat, cross-kind name set that two differently-kinded managed resources
+// could collide on.
+type ManagedResource struct {
+	GVR       *client.GVR
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// TrackingSelector returns the label selector Argo CD uses to associate live
+// objects back to this Application's instance name.
+func TrackingSelector(appName string) string {
+	return appInstanceLabel + "=" + appName
+}
+
+// Sync triggers the Argo CD sync operation for the given Application by
+// merge-patching its top-level operation field, after checking the current
+// user may patch the Application.
+func (a *Application) Sync(ctx context.Context, path string) error {
+	ns, n := client.Namespaced(path)
+	gvr := a.GVR()
+	if auth, err := a.Client().CanI(ns, gvr, "patch"); err != nil {
+		return err
+	} else if !auth {
+		return fmt.Errorf("current user is not authorized to sync application %q", path)
+	}
+
+	dial, err := a.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	patch := []byte(`{"operation":{"sync":{},"initiatedBy":{"username":"k9s"}}}`)
+	_, err = dial.Resource(gvr.GVR()).Namespace(ns).Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
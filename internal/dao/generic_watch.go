@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ Watchable = (*Generic)(nil)
+
+// Watch satisfies dao.Watchable off the shared informer for this
+// accessor's GVR, registering h against it and blocking until ctx is done
+// or the registration has to be torn down. Every accessor embedding
+// Generic -- Pod, Container, Event and friends included -- gets this for
+// free, so Table.Watch only needs the polling path for GVRs that genuinely
+// can't sustain a watch.
+func (g *Generic) Watch(ctx context.Context, ns string, sel labels.Selector, h WatchHandler) error {
+	watchable, err := g.Client().CanWatch(g.GVR())
+	if err != nil {
+		return err
+	}
+	if !watchable {
+		return fmt.Errorf("discovery reports %q does not support watch", g.GVR())
+	}
+
+	inf, release, err := acquireInformer(g.Client(), g.GVR(), ns, sel)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	reg, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(o interface{}) {
+			if ro, ok := o.(runtime.Object); ok {
+				h.OnAdd(ro)
+			}
+		},
+		UpdateFunc: func(oldO, newO interface{}) {
+			old, ok1 := oldO.(runtime.Object)
+			cur, ok2 := newO.(runtime.Object)
+			if ok1 && ok2 {
+				h.OnUpdate(old, cur)
+			}
+		},
+		DeleteFunc: func(o interface{}) {
+			if ro, ok := o.(runtime.Object); ok {
+				h.OnDelete(ro)
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = inf.RemoveEventHandler(reg)
+	}()
+
+	if !cache.WaitForCacheSync(ctx.Done(), inf.HasSynced) {
+		return fmt.Errorf("informer cache sync failed for %q", g.GVR())
+	}
+
+	<-ctx.Done()
+
+	return ctx.Err()
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// informerKey identifies a single shared informer. Table instances asking
+// to watch the same GVR/namespace/labelSelector combination are handed the
+// same informer and underlying watch, so N Tables never cost N watches.
+type informerKey struct {
+	gvr string
+	ns  string
+	sel string
+}
+
+// sharedEntry is one running informer plus the bookkeeping needed to keep
+// it alive independently of any single caller's context. stopCh is closed
+// only when the last caller releases its reference, never when any one
+// caller's ctx is done -- a Table navigating away must not take every other
+// Table sharing this GVR/ns/selector down with it.
+type sharedEntry struct {
+	inf      cache.SharedIndexInformer
+	stopCh   chan struct{}
+	refCount int
+}
+
+var (
+	informersMx sync.Mutex
+	informers   = make(map[informerKey]*sharedEntry)
+)
+
+// acquireInformer returns the shared informer for this GVR/namespace/
+// labelSelector, starting it against its own stop channel, independent of
+// any caller's context, the first time it's requested, and hands back a release func the caller
+// must invoke exactly once when it stops watching. The informer keeps
+// running, shared by every other caller, until the last reference is
+// released, at which point it's stopped and evicted so a later caller
+// starts a fresh one.
+func acquireInformer(conn client.Connection, gvr *client.GVR, ns string, sel labels.Selector) (cache.SharedIndexInformer, func(), error) {
+	selStr := ""
+	if sel != nil {
+		selStr = sel.String()
+	}
+	key := informerKey{gvr: gvr.String(), ns: ns, sel: selStr}
+
+	informersMx.Lock()
+	defer informersMx.Unlock()
+
+	e, ok := informers[key]
+	if !ok {
+		dial, err := conn.DynDial()
+		if err != nil {
+			return nil, nil, err
+		}
+		factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dial, 0, ns, func(o *metav1.ListOptions) {
+			o.LabelSelector = selStr
+		})
+		e = &sharedEntry{
+			inf:    factory.ForResource(gvr.GVR()).Informer(),
+			stopCh: make(chan struct{}),
+		}
+		informers[key] = e
+		go e.inf.Run(e.stopCh)
+	}
+	e.refCount++
+
+	released := false
+	release := func() {
+		informersMx.Lock()
+		defer informersMx.Unlock()
+		if released {
+			return
+		}
+		released = true
+
+		e.refCount--
+		if e.refCount <= 0 {
+			close(e.stopCh)
+			delete(informers, key)
+		}
+	}
+
+	return e.inf, release, nil
+}
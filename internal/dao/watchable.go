@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// WatchHandler receives the delta events emitted by a shared informer as
+// they arrive, instead of a full list snapshot on every refresh tick.
+type WatchHandler interface {
+	// OnAdd is invoked when the informer observes a new object.
+	OnAdd(o runtime.Object)
+
+	// OnUpdate is invoked when the informer observes a changed object.
+	OnUpdate(oldObj, newObj runtime.Object)
+
+	// OnDelete is invoked when the informer observes an object removal.
+	OnDelete(o runtime.Object)
+}
+
+// Watchable is implemented by accessors that can stream resource changes
+// off a shared informer rather than being polled via List on every tick.
+// Generic implements it for every accessor that embeds it, backed by the
+// shared informer factory in informer.go. GVRs the discovery client
+// reports as non-watchable (eg. metrics.k8s.io) fail Watch immediately with
+// an error instead of starting an informer that would sync off its initial
+// List and then hang forever -- Table.watcher's retry backoff exhausts
+// against that error the same as it would a dropped connection, so the
+// caller falls back to plain polling.
+type Watchable interface {
+	// Watch registers h against the shared informer for this
+	// GVR/namespace/labelSelector and blocks until ctx is done or the
+	// informer can no longer be kept in sync, in which case it returns an
+	// error so the caller can retry or fall back to polling.
+	Watch(ctx context.Context, ns string, sel labels.Selector, h WatchHandler) error
+}
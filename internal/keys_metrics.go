@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package internal
+
+// KeyMetrics is the context key for the *metrics.Registry, threaded
+// alongside KeyFactory so DAOs and models can record metrics without a
+// direct import of whatever owns the registry.
+const KeyMetrics ContextKey = "metrics"
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package metrics
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// Init builds a Registry, stores it on ctx under internal.KeyMetrics, and --
+// when addr is non-empty -- starts Serve in the background so callers get a
+// single call wiring the opt-in --metrics-addr flag up end to end. addr
+// empty leaves the returned context carrying a live Registry (so
+// ObserveRefresh/SetRows/IncLoadFailures/IncBackoffResets calls still work)
+// without ever opening a listener.
+func Init(ctx context.Context, addr, version string) context.Context {
+	r := New(version)
+	ctx = context.WithValue(ctx, internal.KeyMetrics, r)
+	if addr == "" {
+		return ctx
+	}
+
+	go func() {
+		if err := Serve(ctx, addr, r); err != nil {
+			slog.Error("Metrics server exited", slogs.Error, err)
+		}
+	}()
+
+	return ctx
+}
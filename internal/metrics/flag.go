@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package metrics
+
+import "github.com/spf13/pflag"
+
+// FlagMetricsAddr is the opt-in flag operators pass to expose k9s' runtime
+// and Table subsystem metrics, eg. `k9s --metrics-addr :9090`, registered
+// via AddFlag. Left unset, Init starts no listener; the Registry it stores
+// on the context still records.
+const FlagMetricsAddr = "metrics-addr"
+
+// AddFlag registers --metrics-addr on flags and returns the bound value.
+func AddFlag(flags *pflag.FlagSet) *string {
+	return flags.String(FlagMetricsAddr, "", "Expose k9s runtime and Table metrics on a Prometheus /metrics endpoint, eg. :9090 (disabled by default)")
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package metrics exposes k9s' own runtime and Table subsystem health as
+// Prometheus collectors, opt-in via the --metrics-addr flag for operators
+// running k9s headless or in CI.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Registry owns k9s' Prometheus collectors. A nil *Registry is valid and
+// every method is a no-op, so instrumented call sites don't need to branch
+// on whether --metrics-addr was set.
+type Registry struct {
+	reg *prometheus.Registry
+
+	refreshTotal    *prometheus.CounterVec
+	refreshDuration *prometheus.HistogramVec
+	rows            *prometheus.GaugeVec
+	loadFailures    *prometheus.CounterVec
+	backoffResets   *prometheus.CounterVec
+}
+
+// New builds a Registry wired with the Table subsystem collectors plus the
+// standard process/Go runtime collectors and build info.
+func New(version string) *Registry {
+	reg := prometheus.NewRegistry()
+	f := promauto.With(reg)
+
+	reg.MustRegister(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewGoCollector(),
+	)
+	f.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   "k9s",
+		Name:        "build_info",
+		Help:        "k9s build information.",
+		ConstLabels: prometheus.Labels{"version": version},
+	}, func() float64 { return 1 })
+
+	return &Registry{
+		reg: reg,
+		refreshTotal: f.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "k9s",
+			Subsystem: "table",
+			Name:      "refresh_total",
+			Help:      "Number of Table refreshes, by GVR and result (ok|error).",
+		}, []string{"gvr", "result"}),
+		refreshDuration: f.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "k9s",
+			Subsystem: "table",
+			Name:      "refresh_duration_seconds",
+			Help:      "Latency of Table.refresh -- including the DAO List round-trip -- by GVR.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"gvr"}),
+		rows: f.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "k9s",
+			Subsystem: "table",
+			Name:      "rows",
+			Help:      "Number of rows currently visible in a Table, by GVR and namespace.",
+		}, []string{"gvr", "namespace"}),
+		loadFailures: f.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "k9s",
+			Subsystem: "table",
+			Name:      "load_failures_total",
+			Help:      "Number of Table loads that failed after exhausting their retry backoff, by GVR.",
+		}, []string{"gvr"}),
+		backoffResets: f.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "k9s",
+			Subsystem: "table",
+			Name:      "backoff_resets_total",
+			Help:      "Number of times Table.updater recovered after one or more failed attempts, by GVR.",
+		}, []string{"gvr"}),
+	}
+}
+
+// ObserveRefresh records the outcome and latency of a Table.refresh call.
+func (r *Registry) ObserveRefresh(gvr string, err error, dur time.Duration) {
+	if r == nil {
+		return
+	}
+
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	r.refreshTotal.WithLabelValues(gvr, result).Inc()
+	r.refreshDuration.WithLabelValues(gvr).Observe(dur.Seconds())
+}
+
+// SetRows records the number of rows currently visible for gvr/ns.
+func (r *Registry) SetRows(gvr, ns string, n int) {
+	if r == nil {
+		return
+	}
+
+	r.rows.WithLabelValues(gvr, ns).Set(float64(n))
+}
+
+// IncLoadFailures increments the load-failure counter for gvr.
+func (r *Registry) IncLoadFailures(gvr string) {
+	if r == nil {
+		return
+	}
+
+	r.loadFailures.WithLabelValues(gvr).Inc()
+}
+
+// IncBackoffResets increments the backoff-reset counter for gvr, recorded
+// whenever Table.updater's retry succeeds after at least one failed
+// attempt.
+func (r *Registry) IncBackoffResets(gvr string) {
+	if r == nil {
+		return
+	}
+
+	r.backoffResets.WithLabelValues(gvr).Inc()
+}
+
+// Registerer exposes the underlying registry so DAOs and other models can
+// register their own collectors -- eg. DAO list latency -- without
+// importing this package's internals or creating an import cycle back into
+// internal/model.
+func (r *Registry) Registerer() prometheus.Registerer {
+	if r == nil {
+		return nil
+	}
+
+	return r.reg
+}
+
+// Gatherer satisfies promhttp's dependency for serving /metrics.
+func (r *Registry) Gatherer() prometheus.Gatherer {
+	if r == nil {
+		return prometheus.NewRegistry()
+	}
+
+	return r.reg
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// IndexFunc computes the set of index keys a row maps to under a given
+// named index, mirroring client-go's cache.IndexFunc.
+type IndexFunc func(r model1.Row) []string
+
+// indexer maintains named secondary indexes over a Table's current
+// TableData so filter facets can be resolved to their matching row IDs in
+// O(1) instead of a linear scan of the row slice.
+type indexer struct {
+	mx  sync.RWMutex
+	fns map[string]IndexFunc
+	idx map[string]map[string][]string // index name -> key -> row IDs
+}
+
+func newIndexer() *indexer {
+	return &indexer{
+		fns: make(map[string]IndexFunc),
+		idx: make(map[string]map[string][]string),
+	}
+}
+
+func (ix *indexer) addIndexer(name string, fn IndexFunc) {
+	ix.mx.Lock()
+	defer ix.mx.Unlock()
+
+	ix.fns[name] = fn
+}
+
+func (ix *indexer) byIndex(name, key string) []string {
+	ix.mx.RLock()
+	defer ix.mx.RUnlock()
+
+	if len(ix.idx[name]) == 0 {
+		return nil
+	}
+
+	return append([]string(nil), ix.idx[name][key]...)
+}
+
+// rebuild recomputes every registered index off data. It must run whenever
+// reconcile produces a new TableData -- before TableDataChanged fires -- so
+// a lookup never observes a row set the indexes haven't caught up to yet.
+func (ix *indexer) rebuild(data *model1.TableData) {
+	ix.mx.Lock()
+	defer ix.mx.Unlock()
+
+	if len(ix.fns) == 0 {
+		return
+	}
+
+	fresh := make(map[string]map[string][]string, len(ix.fns))
+	for name := range ix.fns {
+		fresh[name] = make(map[string][]string)
+	}
+	data.RowsRange(func(id string, re model1.RowEvent) bool {
+		for name, fn := range ix.fns {
+			for _, key := range fn(re.Row) {
+				fresh[name][key] = append(fresh[name][key], id)
+			}
+		}
+		return true
+	})
+	ix.idx = fresh
+}
+
+// facet is one "name=value" term Filter resolves via ByIndex.
+type facet struct {
+	name, value string
+}
+
+// splitFacets pulls the "name=value" indexed terms out of a filter query,
+// returning them alongside whatever free text is left to substring-match.
+func splitFacets(q string) ([]facet, string) {
+	var (
+		facets []facet
+		rest   []string
+	)
+	for _, tok := range strings.Fields(q) {
+		name, value, ok := strings.Cut(tok, "=")
+		if !ok || name == "" || value == "" {
+			rest = append(rest, tok)
+			continue
+		}
+		facets = append(facets, facet{name: name, value: value})
+	}
+
+	return facets, strings.Join(rest, " ")
+}
+
+// rowMatches reports whether any field in r contains text, case-sensitive
+// substring match -- the same semantics the unindexed linear scan used.
+func rowMatches(r model1.Row, text string) bool {
+	for _, f := range r.Fields {
+		if strings.Contains(f, text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allRowIDs returns every row ID currently in data, used as Filter's
+// starting set when no facet narrows it first.
+func allRowIDs(data *model1.TableData) []string {
+	ids := make([]string, 0, data.RowCount())
+	data.RowsRange(func(id string, _ model1.RowEvent) bool {
+		ids = append(ids, id)
+		return true
+	})
+
+	return ids
+}
+
+// intersectIDs returns the IDs present in both a and b.
+func intersectIDs(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, id := range b {
+		in[id] = true
+	}
+
+	out := make([]string, 0, len(a))
+	for _, id := range a {
+		if in[id] {
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+)
+
+const benchRowCount = 20_000
+
+// BenchmarkByIndex exercises the real Table.AddIndexer/Filter path a
+// CmdBuff keystroke would hit, not the bare indexer, so it actually proves
+// something about filter-on-keystroke latency on a 20k-row table.
+func BenchmarkByIndex(b *testing.B) {
+	t := NewTable(client.NewGVR("test"))
+	t.data = make20kRows()
+	t.AddIndexer("namespace", func(r model1.Row) []string {
+		return []string{r.Fields[0]}
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t.Filter("namespace=ns-42")
+	}
+}
+
+func make20kRows() *model1.TableData {
+	evts := make(model1.RowEvents, 0, benchRowCount)
+	for i := 0; i < benchRowCount; i++ {
+		evts = append(evts, model1.RowEvent{
+			Row: model1.Row{
+				Fields: model1.Fields{fmt.Sprintf("ns-%d", i%100), fmt.Sprintf("pod-%d", i)},
+			},
+		})
+	}
+
+	return model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "NAMESPACE"},
+			model1.HeaderColumn{Name: "NAME"},
+		},
+		evts,
+	)
+}
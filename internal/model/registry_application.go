@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+)
+
+func init() {
+	Registry[client.ApplicationGVR.String()] = ResourceMeta{
+		DAO:      &dao.Application{},
+		Renderer: &render.Application{},
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/metrics"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -48,15 +50,39 @@ type Table struct {
 	labelSelector labels.Selector
 	mx            sync.RWMutex
 	vs            *config.ViewSetting
+	watchCtx      context.Context
+	ix            *indexer
+	predicate     func(runtime.Object) bool
 }
 
 // NewTable returns a new table model.
 func NewTable(gvr *client.GVR) *Table {
-	return &Table{
+	t := &Table{
 		gvr:         gvr,
 		data:        model1.NewTableData(gvr),
 		refreshRate: 2 * time.Second,
 	}
+	// Field positions vary by GVR -- cluster-scoped views (Node, Namespace,
+	// CRDs, ...) have no NAMESPACE column and put NAME first -- so the
+	// column is resolved off the current Header on every call instead of
+	// assuming a fixed position; IndexOf returning -1 for a missing column
+	// just leaves that row unindexed rather than indexing the wrong field.
+	t.AddIndexer("namespace", func(r model1.Row) []string {
+		idx := t.data.Header().IndexOf("NAMESPACE", true)
+		if idx < 0 || idx >= len(r.Fields) {
+			return nil
+		}
+		return []string{r.Fields[idx]}
+	})
+	t.AddIndexer("name", func(r model1.Row) []string {
+		idx := t.data.Header().IndexOf("NAME", true)
+		if idx < 0 || idx >= len(r.Fields) {
+			return nil
+		}
+		return []string{r.Fields[idx]}
+	})
+
+	return t
 }
 
 func (t *Table) SetViewSetting(ctx context.Context, vs *config.ViewSetting) {
@@ -117,16 +143,155 @@ func (t *Table) RemoveListener(l TableListener) {
 	}
 }
 
-// Watch initiates model updates.
+// Watch initiates model updates. Whenever the backing accessor exposes a
+// shared informer, updates are event-driven off Add/Update/Delete deltas;
+// otherwise -- or should the informer later fail to stay in sync -- Table
+// falls back to the legacy polling loop.
 func (t *Table) Watch(ctx context.Context) error {
 	if err := t.refresh(ctx); err != nil {
 		return err
 	}
+
+	meta := resourceMeta(t.gvr)
+	if w, ok := meta.DAO.(dao.Watchable); ok {
+		t.mx.Lock()
+		t.watchCtx = ctx
+		t.mx.Unlock()
+		go t.watcher(ctx, w)
+		return nil
+	}
+
 	go t.updater(ctx)
 
 	return nil
 }
 
+// watcher keeps a shared informer alive for this table, retrying with
+// backoff on disconnects, and falls back to polling once retries exhaust.
+func (t *Table) watcher(ctx context.Context, w dao.Watchable) {
+	ns := client.CleanseNamespace(t.data.GetNamespace())
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+
+	bf := backoff.NewExponentialBackOff()
+	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
+	err := backoff.Retry(func() error {
+		return w.Watch(ctx, ns, t.GetLabelSelector(), t)
+	}, backoff.WithContext(bf, ctx))
+	if err == nil || ctx.Err() != nil {
+		return
+	}
+
+	slog.Warn("Informer watch exhausted retries, falling back to polling", slogs.GVR, t.gvr, slogs.Error, err)
+	go t.updater(ctx)
+}
+
+// OnAdd satisfies dao.WatchHandler by rendering the added object straight
+// into TableData, instead of re-listing the whole GVR for one event.
+func (t *Table) OnAdd(o runtime.Object) {
+	t.applyUpsert(o)
+}
+
+// OnUpdate satisfies dao.WatchHandler by re-rendering the changed object in
+// place, instead of re-listing the whole GVR for one event.
+func (t *Table) OnUpdate(_, o runtime.Object) {
+	t.applyUpsert(o)
+}
+
+// OnDelete satisfies dao.WatchHandler by dropping the row in place, instead
+// of re-listing the whole GVR for one event.
+func (t *Table) OnDelete(o runtime.Object) {
+	ctx, ok := t.watchContext()
+	if !ok {
+		return
+	}
+
+	id, err := rowID(o)
+	if err != nil {
+		slog.Error("Delete delta discarded", slogs.GVR, t.gvr, slogs.Error, err)
+		return
+	}
+
+	t.mx.Lock()
+	t.data.Delete(id)
+	if t.ix != nil {
+		t.ix.rebuild(t.data)
+	}
+	t.mx.Unlock()
+
+	t.fireCurrent(ctx)
+}
+
+// applyUpsert renders o and writes the resulting row directly into the
+// table's TableData, mirroring what reconcile does for this one object but
+// without the DAO.List round-trip -- the informer already handed us the
+// object, so re-listing every other row along with it would be strictly
+// worse than the polling loop this replaces.
+func (t *Table) applyUpsert(o runtime.Object) {
+	ctx, ok := t.watchContext()
+	if !ok {
+		return
+	}
+	t.mx.RLock()
+	pred := t.predicate
+	t.mx.RUnlock()
+	if pred != nil && !pred(o) {
+		return
+	}
+
+	meta := resourceMeta(t.gvr)
+	r := meta.Renderer
+	r.SetViewSetting(t.vs)
+
+	t.mx.Lock()
+	err := t.data.Set(ctx, r, o)
+	if err == nil && t.ix != nil {
+		t.ix.rebuild(t.data)
+	}
+	t.mx.Unlock()
+	if err != nil {
+		slog.Error("Delta apply failed", slogs.GVR, t.gvr, slogs.Error, err)
+		return
+	}
+
+	t.fireCurrent(ctx)
+}
+
+// watchContext returns the context captured by Watch, or false if the
+// table isn't currently informer-driven.
+func (t *Table) watchContext() (context.Context, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.watchCtx, t.watchCtx != nil
+}
+
+// fireCurrent notifies listeners of the table's current data, mirroring
+// refresh's fire semantics for a single delta instead of a full reload.
+func (t *Table) fireCurrent(ctx context.Context) {
+	data := t.Peek()
+	if data.RowCount() == 0 {
+		t.fireNoData(data)
+		return
+	}
+	if m, ok := ctx.Value(internal.KeyMetrics).(*metrics.Registry); ok {
+		m.SetRows(t.gvr.String(), data.GetNamespace(), data.RowCount())
+	}
+	t.fireTableChanged(data)
+}
+
+// rowID extracts the row identity reconcile would have assigned this
+// object, so a delete delta can drop the right row without a re-render.
+func rowID(o runtime.Object) (string, error) {
+	acc, err := apimeta.Accessor(o)
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(acc.GetNamespace(), acc.GetName()), nil
+}
+
 // Refresh updates the table content.
 func (t *Table) Refresh(ctx context.Context) error {
 	return t.refresh(ctx)
@@ -200,6 +365,109 @@ func (t *Table) Peek() *model1.TableData {
 	return t.data.Clone()
 }
 
+// AddIndexer registers a named secondary index -- eg. namespace, node,
+// ownerRef, or a labelKey=value facet -- so ByIndex can resolve it to the
+// matching row IDs without a linear scan of the current row set.
+func (t *Table) AddIndexer(name string, fn IndexFunc) {
+	t.mx.Lock()
+	if t.ix == nil {
+		t.ix = newIndexer()
+	}
+	ix := t.ix
+	t.mx.Unlock()
+
+	ix.addIndexer(name, fn)
+	ix.rebuild(t.data)
+}
+
+// ByIndex returns the row IDs matching key under the named index, or nil if
+// the index hasn't been registered via AddIndexer.
+func (t *Table) ByIndex(name, key string) []string {
+	t.mx.RLock()
+	ix := t.ix
+	t.mx.RUnlock()
+	if ix == nil {
+		return nil
+	}
+
+	return ix.byIndex(name, key)
+}
+
+// Filter resolves q -- a space-separated mix of "index=value" facets and a
+// trailing free-text term -- to the matching row IDs. Facets are resolved
+// via ByIndex and intersected; only the rows that survive them, if any,
+// pay for a substring scan against the remaining free text. A facet whose
+// index isn't registered matches no rows. With no indexer at all the
+// facets are ignored and only the free text is scanned, linearly.
+func (t *Table) Filter(q string) []string {
+	facets, text := splitFacets(q)
+
+	t.mx.RLock()
+	ix := t.ix
+	t.mx.RUnlock()
+
+	data := t.Peek()
+	ids := allRowIDs(data)
+	if ix != nil {
+		for i, f := range facets {
+			hits := ix.byIndex(f.name, f.value)
+			if i == 0 {
+				ids = hits
+				continue
+			}
+			ids = intersectIDs(ids, hits)
+		}
+	}
+
+	if text == "" {
+		return ids
+	}
+
+	allow := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		allow[id] = true
+	}
+
+	kept := make([]string, 0, len(ids))
+	data.RowsRange(func(id string, re model1.RowEvent) bool {
+		if allow[id] && rowMatches(re.Row, text) {
+			kept = append(kept, id)
+		}
+		return true
+	})
+
+	return kept
+}
+
+// GetFilteredData resolves q via Filter and returns the TableData pruned
+// down to just the matching rows. This is the entry point a CmdBuff-driven
+// filter-on-keystroke view should call instead of scanning Peek()'s rows by
+// hand, so every consumer benefits from the index lookups Filter already
+// does.
+func (t *Table) GetFilteredData(q string) *model1.TableData {
+	data := t.Peek()
+	if q == "" {
+		return data
+	}
+
+	keep := make(map[string]bool)
+	for _, id := range t.Filter(q) {
+		keep[id] = true
+	}
+	var drop []string
+	data.RowsRange(func(id string, _ model1.RowEvent) bool {
+		if !keep[id] {
+			drop = append(drop, id)
+		}
+		return true
+	})
+	for _, id := range drop {
+		data.Delete(id)
+	}
+
+	return data
+}
+
 func (t *Table) updater(ctx context.Context) {
 	bf := backoff.NewExponentialBackOff()
 	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
@@ -210,7 +478,9 @@ func (t *Table) updater(ctx context.Context) {
 			return
 		case <-time.After(rate):
 			rate = t.refreshRate
+			var attempts int
 			err := backoff.Retry(func() error {
+				attempts++
 				if err := t.refresh(ctx); err != nil {
 					slog.Error("Refresh failed", slogs.GVR, t.gvr)
 					return err
@@ -219,9 +489,17 @@ func (t *Table) updater(ctx context.Context) {
 			}, backoff.WithContext(bf, ctx))
 			if err != nil {
 				slog.Warn("Reconciler exited", slogs.Error, err)
+				if m, ok := ctx.Value(internal.KeyMetrics).(*metrics.Registry); ok {
+					m.IncLoadFailures(t.gvr.String())
+				}
 				t.fireTableLoadFailed(err)
 				return
 			}
+			if attempts > 1 {
+				if m, ok := ctx.Value(internal.KeyMetrics).(*metrics.Registry); ok {
+					m.IncBackoffResets(t.gvr.String())
+				}
+			}
 		}
 	}
 }
@@ -233,13 +511,19 @@ func (t *Table) refresh(ctx context.Context) error {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
-	if err := t.reconcile(ctx); err != nil {
+	m, _ := ctx.Value(internal.KeyMetrics).(*metrics.Registry)
+	start := time.Now()
+	err := t.reconcile(ctx)
+	m.ObserveRefresh(t.gvr.String(), err, time.Since(start))
+	if err != nil {
 		return err
 	}
+
 	data := t.Peek()
 	if data.RowCount() == 0 {
 		t.fireNoData(data)
 	} else {
+		m.SetRows(t.gvr.String(), data.GetNamespace(), data.RowCount())
 		t.fireTableChanged(data)
 	}
 
@@ -255,6 +539,7 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 
 	t.mx.RLock()
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelSelector)
+	pred := t.predicate
 	t.mx.RUnlock()
 
 	ns := client.CleanseNamespace(t.data.GetNamespace())
@@ -262,7 +547,30 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 		ns = client.BlankNamespace
 	}
 
-	return a.List(ctx, ns)
+	oo, err := a.List(ctx, ns)
+	if err != nil || pred == nil {
+		return oo, err
+	}
+
+	kept := oo[:0]
+	for _, o := range oo {
+		if pred(o) {
+			kept = append(kept, o)
+		}
+	}
+
+	return kept, nil
+}
+
+// SetPredicate further restricts listed objects beyond the
+// namespace/labelSelector facets already applied server-side -- eg. so a
+// drill-down view can scope to exactly the objects an owning resource
+// reports managing, which no label or field selector alone can express.
+func (t *Table) SetPredicate(fn func(runtime.Object) bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.predicate = fn
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
@@ -287,7 +595,18 @@ func (t *Table) reconcile(ctx context.Context) error {
 	r := meta.Renderer
 	r.SetViewSetting(t.vs)
 
-	return t.data.Render(ctx, meta.Renderer, oo)
+	if err := t.data.Render(ctx, meta.Renderer, oo); err != nil {
+		return err
+	}
+
+	t.mx.RLock()
+	ix := t.ix
+	t.mx.RUnlock()
+	if ix != nil {
+		ix.rebuild(t.data)
+	}
+
+	return nil
 }
 
 func (t *Table) fireTableChanged(data *model1.TableData) {
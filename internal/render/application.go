@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/gdamore/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Application renders an Argo CD Application CR to screen.
+type Application struct {
+	Base
+}
+
+// Header returns the resource header.
+func (a Application) Header(_ string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "SYNC"},
+		model1.HeaderColumn{Name: "HEALTH"},
+		model1.HeaderColumn{Name: "REVISION"},
+		model1.HeaderColumn{Name: "AUTO-SYNC"},
+		model1.HeaderColumn{Name: "PRUNE"},
+		model1.HeaderColumn{Name: "SELF-HEAL"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true, Decorator: AgeDecorator}},
+	}
+}
+
+// Render renders an Application to screen.
+func (a Application) Render(o interface{}, ns string, r *model1.Row) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected *unstructured.Unstructured but got %T", o)
+	}
+
+	sync, _, _ := unstructured.NestedString(u.Object, "status", "sync", "status")
+	health, _, _ := unstructured.NestedString(u.Object, "status", "health", "status")
+	revision, _, _ := unstructured.NestedString(u.Object, "status", "sync", "revision")
+	autoSync, hasAuto, _ := unstructured.NestedMap(u.Object, "spec", "syncPolicy", "automated")
+	prune, selfHeal := "false", "false"
+	if hasAuto {
+		if v, ok, _ := unstructured.NestedBool(autoSync, "prune"); ok && v {
+			prune = "true"
+		}
+		if v, ok, _ := unstructured.NestedBool(autoSync, "selfHeal"); ok && v {
+			selfHeal = "true"
+		}
+	}
+	autoSyncEnabled := "false"
+	if hasAuto {
+		autoSyncEnabled = "true"
+	}
+
+	r.ID = client.FQN(u.GetNamespace(), u.GetName())
+	r.Fields = model1.Fields{
+		u.GetNamespace(),
+		u.GetName(),
+		na(sync),
+		na(health),
+		na(revision),
+		autoSyncEnabled,
+		prune,
+		selfHeal,
+		toAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ColorerFunc colors a resource row based on its health status.
+func (Application) ColorerFunc() model1.ColorerFunc {
+	return func(ns string, h model1.Header, re model1.RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		health := re.Row.Fields[h.IndexOf("HEALTH", true)]
+		switch health {
+		case "Degraded":
+			c = ErrColor
+		case "Progressing":
+			c = AddColor
+		}
+
+		return c
+	}
+}
+
+func na(s string) string {
+	if s == "" {
+		return "n/a"
+	}
+
+	return s
+}
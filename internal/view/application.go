@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell/v2"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Application presents an Argo CD Application CR view.
+type Application struct {
+	ResourceViewer
+}
+
+// NewApplication returns a new Application view.
+func NewApplication(gvr *client.GVR) *Application {
+	a := Application{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.AddBindKeysFn(a.bindKeys)
+	a.GetTable().SetEnterFn(a.drillDown)
+
+	return &a
+}
+
+func (a *Application) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyS, ui.NewKeyAction("Sync", a.syncCmd, true))
+}
+
+// drillDown pushes one child Table per distinct kind this Application
+// manages -- a real Application typically spans several (Deployment,
+// Service, ConfigMap, ...) -- scoped to those objects via the tracking
+// label plus an owner-reference predicate, so resources that don't carry
+// the tracking label themselves (eg. a Pod owned directly by a managed
+// StatefulSet) still show up.
+func (a *Application) drillDown(app *App, _ ui.Tabular, gvr *client.GVR, path string) {
+	ad, err := a.accessor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	rr, err := ad.ManagedResources(app.Context(), path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if len(rr) == 0 {
+		app.Flash().Info("Application manages no live resources")
+		return
+	}
+
+	_, n := client.Namespaced(path)
+	sel, err := labels.Parse(dao.TrackingSelector(n))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var order []*client.GVR
+	byGVR := make(map[string][]dao.ManagedResource)
+	ownerKeys := make(map[string]struct{}, len(rr))
+	for _, r := range rr {
+		key := r.GVR.String()
+		if _, ok := byGVR[key]; !ok {
+			order = append(order, r.GVR)
+		}
+		byGVR[key] = append(byGVR[key], r)
+		ownerKeys[ownerKey(r.Kind, r.Name)] = struct{}{}
+	}
+
+	for _, kindGVR := range order {
+		managed := make(map[string]struct{}, len(byGVR[kindGVR.String()]))
+		for _, r := range byGVR[kindGVR.String()] {
+			managed[client.FQN(r.Namespace, r.Name)] = struct{}{}
+		}
+
+		v := NewBrowser(kindGVR)
+		if err := v.Init(app.Context()); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		v.SetPredicate(managedPredicate(sel, managed, ownerKeys))
+		app.Content.Push(v)
+	}
+}
+
+// ownerKey scopes an owner-reference match to kind+name rather than a bare
+// name, so eg. a ConfigMap and a Deployment the Application happens to
+// manage under the same name can't be confused for one another.
+func ownerKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// managedPredicate reports whether an object carries the Application's
+// tracking label (sel), is one Argo explicitly lists as managed, or is
+// directly owned by one of the managed resources (eg. a Pod owned by a
+// managed ReplicaSet/StatefulSet) -- covering objects that don't carry the
+// tracking label themselves. The owner-reference fallback is scoped to the
+// owner's kind+name, not name alone, so it can't match a differently-kinded
+// managed resource that happens to share a name.
+func managedPredicate(sel labels.Selector, managed, ownerKeys map[string]struct{}) func(runtime.Object) bool {
+	return func(o runtime.Object) bool {
+		acc, err := apimeta.Accessor(o)
+		if err != nil {
+			return false
+		}
+		if sel.Matches(labels.Set(acc.GetLabels())) {
+			return true
+		}
+		if _, ok := managed[client.FQN(acc.GetNamespace(), acc.GetName())]; ok {
+			return true
+		}
+		for _, ref := range acc.GetOwnerReferences() {
+			if _, ok := ownerKeys[ownerKey(ref.Kind, ref.Name)]; ok {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func (a *Application) syncCmd(evt *tcell.EventKey) *tcell.EventKey {
+	app := a.App()
+	path := a.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ad, err := a.accessor(app)
+	if err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Sync Application", fmt.Sprintf("Sync application %s?", path), func() {
+		if err := ad.Sync(app.Context(), path); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.Flash().Infof("Sync triggered for %s", path)
+	}, func() {})
+
+	return nil
+}
+
+// accessor fetches the Application accessor backing drill-down and the
+// sync-command hook. Its Sync checks that the current user may patch the
+// Application before it sends the sync operation.
+func (a *Application) accessor(app *App) (*dao.Application, error) {
+	acc, err := dao.AccessorFor(app.factory, a.GVR())
+	if err != nil {
+		return nil, err
+	}
+	ad, ok := acc.(*dao.Application)
+	if !ok {
+		return nil, fmt.Errorf("expected *dao.Application accessor but got %T", acc)
+	}
+
+	return ad, nil
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import "github.com/derailed/k9s/internal/client"
+
+func init() {
+	Registrar[client.ApplicationGVR.String()] = func(gvr *client.GVR) ResourceViewer {
+		return NewApplication(gvr)
+	}
+	Aliases[client.ApplicationGVR.String()] = []string{"application", "applications", "app", "apps"}
+}